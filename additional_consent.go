@@ -0,0 +1,145 @@
+package iabconsent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AdditionalConsent contains all fields defined in Google's Additional
+// Consent (AC) string, which carries consent for Additional Consent
+// Mode ATP vendors that aren't part of the IAB GVL.
+type AdditionalConsent struct {
+	Version       int
+	ConsentedATPs []int
+	DisclosedATPs []int
+}
+
+// ParseAdditionalConsent takes either a bare Additional Consent string
+// (`<version>~<csv of consented ATP ids>[~<csv of disclosed ATP ids>]`)
+// or a combined `<TC string>~<AC string>` and returns the decoded
+// AdditionalConsent.
+//
+// Example Usage:
+//
+//   var ac, err = iabconsent.ParseAdditionalConsent("1~1.35.41.101")
+func ParseAdditionalConsent(s string) (*AdditionalConsent, error) {
+	if ac, err := parseBareAdditionalConsent(s); err == nil {
+		return ac, nil
+	}
+
+	// s didn't parse as a bare AC string on its own; it may be a
+	// combined TC~AC string, in which case the AC string starts after
+	// the first '~'.
+	if i := strings.IndexByte(s, '~'); i >= 0 {
+		return parseBareAdditionalConsent(s[i+1:])
+	}
+
+	return nil, fmt.Errorf("iabconsent: malformed Additional Consent string: %v", s)
+}
+
+func parseBareAdditionalConsent(s string) (*AdditionalConsent, error) {
+	var parts = strings.Split(s, "~")
+
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("iabconsent: invalid Additional Consent version: %v", parts[0])
+	}
+	if version != 1 && version != 2 {
+		return nil, fmt.Errorf("iabconsent: unsupported Additional Consent version: %v", version)
+	}
+
+	if (version == 1 && len(parts) != 2) || (version == 2 && len(parts) != 3) {
+		return nil, fmt.Errorf("iabconsent: malformed Additional Consent string: %v", s)
+	}
+
+	consentedATPs, err := parseATPIDs(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var ac = &AdditionalConsent{
+		Version:       version,
+		ConsentedATPs: consentedATPs,
+	}
+
+	if version == 2 {
+		disclosedATPs, err := parseATPIDs(parts[2])
+		if err != nil {
+			return nil, err
+		}
+		ac.DisclosedATPs = disclosedATPs
+	}
+
+	return ac, nil
+}
+
+func parseATPIDs(csv string) ([]int, error) {
+	if csv == "" {
+		return nil, nil
+	}
+
+	var fields = strings.Split(csv, ".")
+	var ids = make([]int, 0, len(fields))
+	for _, f := range fields {
+		id, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("iabconsent: invalid ATP id: %v", f)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ATPAllowed returns true if the consumer has consented to Google ATP
+// Vendor ID id.
+func (a *AdditionalConsent) ATPAllowed(id int) bool {
+	for _, atp := range a.ConsentedATPs {
+		if atp == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCombined takes a combined `<TC string>~<AC string>` and returns
+// the decoded v1.1 ParsedConsent alongside the decoded AdditionalConsent.
+// If there is no `~<AC string>` suffix, ac is nil.
+func ParseCombined(s string) (p *ParsedConsent, ac *AdditionalConsent, err error) {
+	var parts = strings.SplitN(s, "~", 2)
+
+	p, err = Parse(parts[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(parts) == 2 {
+		ac, err = ParseAdditionalConsent(parts[1])
+		if err != nil {
+			return p, nil, err
+		}
+	}
+
+	return p, ac, nil
+}
+
+// ParseCombinedV2 takes a combined `<TC string>~<AC string>` and returns
+// the decoded TCF v2.0 ParsedConsentV2 alongside the decoded
+// AdditionalConsent. If there is no `~<AC string>` suffix, ac is nil.
+func ParseCombinedV2(s string) (p *ParsedConsentV2, ac *AdditionalConsent, err error) {
+	var parts = strings.SplitN(s, "~", 2)
+
+	p, err = ParseV2(parts[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(parts) == 2 {
+		ac, err = ParseAdditionalConsent(parts[1])
+		if err != nil {
+			return p, nil, err
+		}
+	}
+
+	return p, ac, nil
+}
@@ -0,0 +1,72 @@
+package iabconsent
+
+import (
+	"github.com/go-check/check"
+)
+
+type AdditionalConsentSuite struct{}
+
+var _ = check.Suite(&AdditionalConsentSuite{})
+
+func (s *AdditionalConsentSuite) TestParseAdditionalConsent_Bare(c *check.C) {
+	var ac, err = ParseAdditionalConsent("1~1.35.41.101")
+	c.Assert(err, check.IsNil)
+	c.Check(ac, check.DeepEquals, &AdditionalConsent{
+		Version:       1,
+		ConsentedATPs: []int{1, 35, 41, 101},
+	})
+}
+
+func (s *AdditionalConsentSuite) TestParseAdditionalConsent_V2Disclosed(c *check.C) {
+	var ac, err = ParseAdditionalConsent("2~1.35~41.101")
+	c.Assert(err, check.IsNil)
+	c.Check(ac, check.DeepEquals, &AdditionalConsent{
+		Version:       2,
+		ConsentedATPs: []int{1, 35},
+		DisclosedATPs: []int{41, 101},
+	})
+}
+
+func (s *AdditionalConsentSuite) TestParseAdditionalConsent_Combined(c *check.C) {
+	var ac, err = ParseAdditionalConsent("BONMj34ONMj34ABACDENALqAAAAAplY~1~1.35.41.101")
+	c.Assert(err, check.IsNil)
+	c.Check(ac, check.DeepEquals, &AdditionalConsent{
+		Version:       1,
+		ConsentedATPs: []int{1, 35, 41, 101},
+	})
+}
+
+func (s *AdditionalConsentSuite) TestParseAdditionalConsent_Errors(c *check.C) {
+	var tests = []string{
+		"3~1.35",
+		"1~1.35~41",
+		"abc",
+	}
+
+	for _, t := range tests {
+		c.Log(t)
+		var _, err = ParseAdditionalConsent(t)
+		c.Check(err, check.NotNil)
+	}
+}
+
+func (s *AdditionalConsentSuite) TestATPAllowed(c *check.C) {
+	var ac = &AdditionalConsent{ConsentedATPs: []int{1, 35}}
+	c.Check(ac.ATPAllowed(1), check.Equals, true)
+	c.Check(ac.ATPAllowed(2), check.Equals, false)
+}
+
+func (s *AdditionalConsentSuite) TestParseCombined(c *check.C) {
+	var p, ac, err = ParseCombined("BONMj34ONMj34ABACDENALqAAAAAplY~1~1.35")
+	c.Assert(err, check.IsNil)
+	c.Check(p.CMPID, check.Equals, 1)
+	c.Check(ac, check.DeepEquals, &AdditionalConsent{
+		Version:       1,
+		ConsentedATPs: []int{1, 35},
+	})
+
+	p, ac, err = ParseCombined("BONMj34ONMj34ABACDENALqAAAAAplY")
+	c.Assert(err, check.IsNil)
+	c.Check(p.CMPID, check.Equals, 1)
+	c.Check(ac, check.IsNil)
+}
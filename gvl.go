@@ -0,0 +1,199 @@
+package iabconsent
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	gvlEndpointV1 = "https://vendor-list.consensu.org/vendor-list.json"
+	gvlEndpointV2 = "https://vendor-list.consensu.org/v2/vendor-list.json"
+)
+
+// GVL is the decoded IAB Global Vendor List: the set of registered
+// vendors and the purposes, legitimate interest purposes, flexible
+// purposes, special features, and features each one declares.
+type GVL struct {
+	VendorListVersion int                   `json:"vendorListVersion"`
+	TCFPolicyVersion  int                   `json:"tcfPolicyVersion"`
+	Vendors           map[string]*GVLVendor `json:"vendors"`
+}
+
+// GVLVendor is a single vendor entry in the Global Vendor List.
+type GVLVendor struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Purposes         []int  `json:"purposes"`
+	LegIntPurposes   []int  `json:"legIntPurposes"`
+	FlexiblePurposes []int  `json:"flexiblePurposes"`
+	SpecialFeatures  []int  `json:"specialFeatures"`
+	Features         []int  `json:"features"`
+}
+
+// Vendor returns the GVLVendor for vendorID, or nil if the GVL has no
+// such vendor.
+func (g *GVL) Vendor(vendorID int) *GVLVendor {
+	if g == nil {
+		return nil
+	}
+	return g.Vendors[strconv.Itoa(vendorID)]
+}
+
+// DeclaresPurpose returns true if v declares purposeID among its
+// (non-legitimate-interest) purposes.
+func (v *GVLVendor) DeclaresPurpose(purposeID int) bool {
+	if v == nil {
+		return false
+	}
+	for _, p := range v.Purposes {
+		if p == purposeID {
+			return true
+		}
+	}
+	return false
+}
+
+// GVLFetcher fetches and caches the Global Vendor List. The zero value
+// uses http.DefaultClient and no on-disk cache.
+type GVLFetcher struct {
+	// Client performs the HTTP request against the IAB endpoints. If
+	// nil, http.DefaultClient is used.
+	Client *http.Client
+	// CacheDir, if non-empty, is a directory that fetched vendor lists
+	// are written to (and may be read back from) keyed by the list's
+	// own VendorListVersion, so historical lists matching a
+	// ParsedConsent's VendorListVersion stay available even if the IAB
+	// endpoint no longer serves them.
+	CacheDir string
+	// EndpointV1 and EndpointV2, if set, override gvlEndpointV1 and
+	// gvlEndpointV2. Tests use these to point FetchGVL at an
+	// httptest.Server; callers normally leave them unset.
+	EndpointV1 string
+	EndpointV2 string
+}
+
+// DefaultGVLFetcher is the GVLFetcher used by the package-level
+// FetchGVL.
+var DefaultGVLFetcher = &GVLFetcher{}
+
+// FetchGVL fetches the latest Global Vendor List using
+// DefaultGVLFetcher. version selects the TCF spec version (1 or 2)
+// whose GVL endpoint to use.
+func FetchGVL(version int) (*GVL, error) {
+	return DefaultGVLFetcher.FetchGVL(version)
+}
+
+// FetchGVL fetches the latest Global Vendor List for the given TCF spec
+// version (1 or 2) and, if f.CacheDir is set, writes it to the cache
+// keyed by its VendorListVersion.
+func (f *GVLFetcher) FetchGVL(version int) (*GVL, error) {
+	var endpoint string
+	switch version {
+	case 1:
+		endpoint = f.EndpointV1
+		if endpoint == "" {
+			endpoint = gvlEndpointV1
+		}
+	case 2:
+		endpoint = f.EndpointV2
+		if endpoint == "" {
+			endpoint = gvlEndpointV2
+		}
+	default:
+		return nil, fmt.Errorf("iabconsent: unsupported GVL version %v", version)
+	}
+
+	var client = f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Get(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("iabconsent: fetching GVL: unexpected status %v", resp.Status)
+	}
+
+	var gvl = &GVL{}
+	if err := json.NewDecoder(resp.Body).Decode(gvl); err != nil {
+		return nil, err
+	}
+
+	if f.CacheDir != "" {
+		var body, err = json.Marshal(gvl)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(f.cachePath(gvl.VendorListVersion), body, 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	return gvl, nil
+}
+
+// FetchCachedGVL reads the Global Vendor List for vendorListVersion out
+// of f.CacheDir, without touching the network. It returns an error if
+// f.CacheDir is unset or no list for that version has been cached.
+func (f *GVLFetcher) FetchCachedGVL(vendorListVersion int) (*GVL, error) {
+	if f.CacheDir == "" {
+		return nil, fmt.Errorf("iabconsent: no CacheDir configured")
+	}
+
+	body, err := os.ReadFile(f.cachePath(vendorListVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	var gvl = &GVL{}
+	if err := json.Unmarshal(body, gvl); err != nil {
+		return nil, err
+	}
+
+	return gvl, nil
+}
+
+func (f *GVLFetcher) cachePath(vendorListVersion int) string {
+	return filepath.Join(f.CacheDir, fmt.Sprintf("vendor-list-%d.json", vendorListVersion))
+}
+
+// VendorAllowedForPurpose returns true if gvl declares that Vendor
+// vendorID may use Purpose purposeID, and p contains affirmative
+// consent for both.
+func (p *ParsedConsent) VendorAllowedForPurpose(gvl *GVL, vendorID, purposeID int) bool {
+	var vendor = gvl.Vendor(vendorID)
+	if vendor == nil || !vendor.DeclaresPurpose(purposeID) {
+		return false
+	}
+	return p.VendorAllowed(vendorID) && p.PurposesAllowed[purposeID]
+}
+
+// VendorAllowedForPurpose returns true if gvl declares that Vendor
+// vendorID may use Purpose purposeID, p contains affirmative consent
+// for both, and no PublisherRestriction on p forbids the combination.
+func (p *ParsedConsentV2) VendorAllowedForPurpose(gvl *GVL, vendorID, purposeID int) bool {
+	var vendor = gvl.Vendor(vendorID)
+	if vendor == nil || !vendor.DeclaresPurpose(purposeID) {
+		return false
+	}
+	if !p.VendorConsentAllowed(vendorID) || !p.PurposesConsent[purposeID] {
+		return false
+	}
+	for _, r := range p.PublisherRestrictions {
+		if r.PurposeID != purposeID || !r.Covers(vendorID) {
+			continue
+		}
+		if r.RestrictionType == NotAllowed || r.RestrictionType == RequireLI {
+			return false
+		}
+	}
+	return true
+}
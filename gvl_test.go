@@ -0,0 +1,112 @@
+package iabconsent
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+
+	"github.com/go-check/check"
+)
+
+type GVLSuite struct{}
+
+var _ = check.Suite(&GVLSuite{})
+
+func (s *GVLSuite) TestVendorAllowedForPurpose(c *check.C) {
+	var gvl = &GVL{
+		Vendors: map[string]*GVLVendor{
+			"1": {ID: 1, Purposes: []int{1, 3}},
+		},
+	}
+
+	var p = &ParsedConsent{
+		PurposesAllowed:  map[int]bool{1: true},
+		ConsentedVendors: map[int]bool{1: true},
+	}
+
+	c.Check(p.VendorAllowedForPurpose(gvl, 1, 1), check.Equals, true)
+	// Vendor doesn't declare purpose 2.
+	c.Check(p.VendorAllowedForPurpose(gvl, 1, 2), check.Equals, false)
+	// No consent recorded for vendor 2.
+	c.Check(p.VendorAllowedForPurpose(gvl, 2, 1), check.Equals, false)
+}
+
+func (s *GVLSuite) TestVendorAllowedForPurposeV2_PublisherRestriction(c *check.C) {
+	var gvl = &GVL{
+		Vendors: map[string]*GVLVendor{
+			"1": {ID: 1, Purposes: []int{1}},
+		},
+	}
+
+	var p = &ParsedConsentV2{
+		PurposesConsent: map[int]bool{1: true},
+		VendorConsents:  &VendorVector{Vendors: map[int]bool{1: true}},
+		PublisherRestrictions: []*PublisherRestriction{
+			{
+				PurposeID:       1,
+				RestrictionType: NotAllowed,
+				RangeEntries:    []*RangeEntry{{StartVendorID: 1, EndVendorID: 1}},
+			},
+		},
+	}
+
+	c.Check(p.VendorAllowedForPurpose(gvl, 1, 1), check.Equals, false)
+}
+
+func (s *GVLSuite) TestGVLFetcher_CacheRoundTrip(c *check.C) {
+	var f = &GVLFetcher{CacheDir: c.MkDir()}
+
+	_, err := f.FetchCachedGVL(23)
+	c.Check(err, check.NotNil)
+
+	var gvl = &GVL{VendorListVersion: 23, Vendors: map[string]*GVLVendor{}}
+	body, err := json.Marshal(gvl)
+	c.Assert(err, check.IsNil)
+	c.Assert(os.WriteFile(f.cachePath(23), body, 0o644), check.IsNil)
+
+	var cached, cacheErr = f.FetchCachedGVL(23)
+	c.Assert(cacheErr, check.IsNil)
+	c.Check(cached.VendorListVersion, check.Equals, 23)
+}
+
+func (s *GVLSuite) TestGVLFetcher_FetchGVL(c *check.C) {
+	var mux = http.NewServeMux()
+	mux.HandleFunc("/v1", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&GVL{VendorListVersion: 1})
+	})
+	mux.HandleFunc("/v2", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(&GVL{VendorListVersion: 2})
+	})
+	var srv = httptest.NewServer(mux)
+	defer srv.Close()
+
+	var f = &GVLFetcher{
+		CacheDir:   c.MkDir(),
+		EndpointV1: srv.URL + "/v1",
+		EndpointV2: srv.URL + "/v2",
+	}
+
+	gvl, err := f.FetchGVL(1)
+	c.Assert(err, check.IsNil)
+	c.Check(gvl.VendorListVersion, check.Equals, 1)
+
+	gvl, err = f.FetchGVL(2)
+	c.Assert(err, check.IsNil)
+	c.Check(gvl.VendorListVersion, check.Equals, 2)
+
+	cached, err := f.FetchCachedGVL(2)
+	c.Assert(err, check.IsNil)
+	c.Check(cached.VendorListVersion, check.Equals, 2)
+}
+
+func (s *GVLSuite) TestGVLFetcher_FetchGVL_UnexpectedStatus(c *check.C) {
+	var srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	var f = &GVLFetcher{EndpointV1: srv.URL}
+	var _, err = f.FetchGVL(1)
+	c.Check(err, check.NotNil)
+}
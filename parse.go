@@ -3,6 +3,7 @@ package iabconsent
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/rupertchen/go-bits"
@@ -66,6 +67,23 @@ func (r *ConsentReader) ReadRangeEntries(n uint) []*RangeEntry {
 	return ret
 }
 
+// ReadVendorVector reads a MaxVendorId/IsRangeEncoding section as found
+// repeatedly throughout the TCF v2.0 core string and its optional
+// segments: a vendor bitfield or a set of range entries, with no notion
+// of a default consent value.
+func (r *ConsentReader) ReadVendorVector() *VendorVector {
+	var v = &VendorVector{}
+	v.MaxVendorID = r.ReadInt(16)
+	v.IsRangeEncoding = r.ReadBool()
+	if v.IsRangeEncoding {
+		v.NumEntries = r.ReadInt(12)
+		v.RangeEntries = r.ReadRangeEntries(uint(v.NumEntries))
+	} else {
+		v.Vendors = r.ReadBitField(uint(v.MaxVendorID))
+	}
+	return v
+}
+
 // Parse takes a base64 Raw URL Encoded string which represents
 // a Vendor Consent String and returns a ParsedConsent with
 // it's fields populated with the values stored in the string.
@@ -102,6 +120,7 @@ func Parse(s string) (p *ParsedConsent, err error) {
 	p.PurposesAllowed = r.ReadBitField(24)
 	p.MaxVendorID = r.ReadInt(16)
 
+	p.ConsentedVendors = map[int]bool{}
 	p.IsRangeEncoding = r.ReadBool()
 	if p.IsRangeEncoding {
 		p.DefaultConsent = r.ReadBool()
@@ -113,3 +132,132 @@ func Parse(s string) (p *ParsedConsent, err error) {
 
 	return p, nil
 }
+
+// ParseV2 takes a base64 Raw URL Encoded string which represents a TCF
+// v2.0 Consent String and returns a ParsedConsentV2 with its fields
+// populated with the values stored in the core string and any optional,
+// dot-separated segments that follow it.
+//
+// Example Usage:
+//
+//   var pc, err = iabconsent.ParseV2("COwGVJOOwGVJOADACHENAPCAAAAAAAAAAAAAAAAAAAA.IFoEUQQgAIQwgIwQABAEAAAAOIAACAIAAAAQAIAgEAACEAAAAAgAQBAAAAAAAGBAAgAAAAAAAFAAECAAAgAAQARAEQAAAAAJAAIAAgAAAYQEAAAQmAgBC3ZAYzUw")
+func ParseV2(s string) (p *ParsedConsentV2, err error) {
+	// This func leverages named returns to return partially parsed content when there is an error
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	var segments = strings.Split(s, ".")
+
+	b, err := base64.RawURLEncoding.DecodeString(segments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var r = NewConsentReader(b)
+
+	// This block of code directly describes the format of the core segment.
+	p = &ParsedConsentV2{}
+	p.Version = r.ReadInt(6)
+	p.Created = r.ReadTime()
+	p.LastUpdated = r.ReadTime()
+	p.CMPID = r.ReadInt(12)
+	p.CMPVersion = r.ReadInt(12)
+	p.ConsentScreen = r.ReadInt(6)
+	p.ConsentLanguage = r.ReadString(2)
+	p.VendorListVersion = r.ReadInt(12)
+	p.TCFPolicyVersion = r.ReadInt(6)
+	p.IsServiceSpecific = r.ReadBool()
+	p.UseNonStandardTexts = r.ReadBool()
+	p.SpecialFeatureOptIns = r.ReadBitField(12)
+	p.PurposesConsent = r.ReadBitField(24)
+	p.PurposesLITransparency = r.ReadBitField(24)
+	p.PurposeOneTreatment = r.ReadBool()
+	p.PublisherCC = r.ReadString(2)
+
+	p.VendorConsents = r.ReadVendorVector()
+	p.VendorLegitimateInterests = r.ReadVendorVector()
+	p.PublisherRestrictions = r.ReadPublisherRestrictions()
+
+	for _, seg := range segments[1:] {
+		if err = p.parseSegment(seg); err != nil {
+			return p, err
+		}
+	}
+
+	return p, nil
+}
+
+// parseSegment decodes a single optional, dot-separated segment of a TCF
+// v2.0 Consent String and merges it into p, dispatching on the 3-bit
+// SegmentType that prefixes every segment.
+func (p *ParsedConsentV2) parseSegment(s string) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+
+	var r = NewConsentReader(b)
+	switch segmentType := r.ReadInt(3); segmentType {
+	case SegmentTypeDisclosedVendors:
+		p.DisclosedVendors = r.ReadVendorVector()
+	case SegmentTypeAllowedVendors:
+		p.AllowedVendors = r.ReadVendorVector()
+	case SegmentTypePublisherTC:
+		p.PubPurposesConsent = r.ReadBitField(24)
+		p.PubPurposesLITransparency = r.ReadBitField(24)
+		p.NumCustomPurposes = r.ReadInt(6)
+		p.CustomPurposesConsent = r.ReadBitField(uint(p.NumCustomPurposes))
+		p.CustomPurposesLITransparency = r.ReadBitField(uint(p.NumCustomPurposes))
+	default:
+		return fmt.Errorf("unrecognized segment type: %v", segmentType)
+	}
+	return nil
+}
+
+// ReadPublisherRestrictions reads the NumPubRestrictions-prefixed list of
+// PublisherRestriction entries found in the TCF v2.0 core string.
+func (r *ConsentReader) ReadPublisherRestrictions() []*PublisherRestriction {
+	var n = r.ReadInt(12)
+	var ret = make([]*PublisherRestriction, 0, n)
+	for i := 0; i < n; i++ {
+		var purposeID = r.ReadInt(6)
+		var restrictionType = RestrictionType(r.ReadInt(2))
+		var numEntries = r.ReadInt(12)
+		ret = append(ret, &PublisherRestriction{
+			PurposeID:       purposeID,
+			RestrictionType: restrictionType,
+			RangeEntries:    r.ReadRangeEntries(uint(numEntries)),
+		})
+	}
+	return ret
+}
+
+// ParseAny reads the 6-bit Version field of a base64 Raw URL Encoded
+// Consent String and routes it to Parse or ParseV2 as appropriate. It is
+// a convenience for callers that accept either version; callers that
+// know which version they expect should call Parse or ParseV2 directly.
+func ParseAny(s string) (v1 *ParsedConsent, v2 *ParsedConsentV2, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	b, err := base64.RawURLEncoding.DecodeString(strings.SplitN(s, ".", 2)[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch version := NewConsentReader(b).ReadInt(6); version {
+	case 2:
+		v2, err = ParseV2(s)
+		return nil, v2, err
+	default:
+		v1, err = Parse(s)
+		return v1, nil, err
+	}
+}
@@ -1,12 +1,20 @@
 package iabconsent_test
 
 import (
+	"testing"
 	"time"
 
 	"github.com/LiveRamp/iabconsent"
 	"github.com/go-check/check"
 )
 
+// Test is the go-check entry point for every check.Suite registered
+// across the package, both here and in package iabconsent's own test
+// files.
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
 type parseSuite struct{}
 
 var _ = check.Suite(&parseSuite{})
@@ -56,7 +64,32 @@ func (s *parseSuite) TestConsentReader_ReadBoolMap(c *check.C) {
 
 	var r = iabconsent.NewConsentReader([]byte{0x5a})
 	for _, t := range tests {
-		c.Check(r.ReadPurposes(t.n), check.DeepEquals, t.expected)
+		c.Check(r.ReadBitField(t.n), check.DeepEquals, t.expected)
 	}
 	c.Check(r.HasUnread(), check.Equals, false)
 }
+
+func (s *parseSuite) TestConsentWriter_WriteInt(c *check.C) {
+	var w = iabconsent.NewConsentWriter()
+	w.WriteInt(1, 1)
+	w.WriteInt(0, 1)
+	w.WriteInt(5, 3)
+	w.WriteInt(2, 3)
+	c.Check(w.Bytes(), check.DeepEquals, []byte{0xaa})
+}
+
+func (s *parseSuite) TestConsentWriter_WriteTime(c *check.C) {
+	// 2018-05-18 17:48:31.5 +0000 UTC
+	// 1526665711.5 s
+	// 15266657115 deci-seconds
+	// 0x38df6b35b deci-seconds (hex)
+	var w = iabconsent.NewConsentWriter()
+	w.WriteTime(time.Unix(1526665711, int64(500*time.Millisecond)).UTC())
+	c.Check(w.Bytes(), check.DeepEquals, []byte{0x38, 0xdf, 0x6b, 0x35, 0xB0})
+}
+
+func (s *parseSuite) TestConsentWriter_WriteBitField(c *check.C) {
+	var w = iabconsent.NewConsentWriter()
+	w.WriteBitField(map[int]bool{2: true, 3: true, 5: true}, 6)
+	c.Check(w.Bytes(), check.DeepEquals, []byte{0x68})
+}
@@ -13,42 +13,7 @@ for use in the LiveRamp Pixel Server.
 */
 package iabconsent
 
-import (
-	"encoding/base64"
-	"time"
-)
-
-// These constants represent the bit offsets and sizes of the
-// fields in the IAB Consent String 1.1 Spec.
-const (
-	VersionBitOffset        = 0
-	VersionBitSize          = 6
-	CreatedBitOffset        = 6
-	CreatedBitSize          = 36
-	UpdatedBitOffset        = 42
-	UpdatedBitSize          = 36
-	CmpIdOffset             = 78
-	CmpIdSize               = 12
-	CmpVersionOffset        = 90
-	CmpVersionSize          = 12
-	ConsentScreenSizeOffset = 102
-	ConsentScreenSize       = 6
-	ConsentLanguageOffset   = 108
-	ConsentLanguageSize     = 12
-	VendorListVersionOffset = 120
-	VendorListVersionSize   = 12
-	PurposesOffset          = 132
-	PurposesSize            = 24
-	MaxVendorIdOffset       = 156
-	MaxVendorIdSize         = 16
-	EncodingTypeOffset      = 172
-	VendorBitFieldOffset    = 173
-	DefaultConsentOffset    = 173
-	NumEntriesOffset        = 174
-	NumEntriesSize          = 12
-	RangeEntryOffset        = 186
-	VendorIdSize            = 16
-)
+import "time"
 
 // ParsedConsent contains all fields defined in the
 // IAB Consent String 1.1 Spec.
@@ -63,11 +28,11 @@ type ParsedConsent struct {
 	VendorListVersion int
 	PurposesAllowed   map[int]bool
 	MaxVendorID       int
-	IsRange           bool
-	approvedVendorIDs map[int]bool
+	IsRangeEncoding   bool
 	DefaultConsent    bool
-	numEntries        int
-	rangeEntries      []*RangeEntry
+	NumEntries        int
+	RangeEntries      []*RangeEntry
+	ConsentedVendors  map[int]bool
 }
 
 // EveryPurposeAllowed returns true iff every purpose number in ps exists in
@@ -84,19 +49,19 @@ func (p *ParsedConsent) EveryPurposeAllowed(ps []int) bool {
 // VendorAllowed returns true if the ParsedConsent contains
 // affirmative consent for Vendor of ID |i|.
 func (p *ParsedConsent) VendorAllowed(i int) bool {
-	if p.IsRange {
+	if p.IsRangeEncoding {
 		// DefaultConsent indicates the consent for those
 		// not covered by any Range Entries. Vendors covered
-		// in rangeEntries have the opposite consent of
+		// in RangeEntries have the opposite consent of
 		// DefaultConsent.
-		for _, re := range p.rangeEntries {
+		for _, re := range p.RangeEntries {
 			if re.StartVendorID <= i &&
 				re.EndVendorID >= i {
 				return !p.DefaultConsent
 			}
 		}
 	} else {
-		var _, ok = p.approvedVendorIDs[i]
+		var _, ok = p.ConsentedVendors[i]
 		return ok
 	}
 	return p.DefaultConsent
@@ -110,143 +75,3 @@ type RangeEntry struct {
 	StartVendorID int
 	EndVendorID   int
 }
-
-// Parse takes a base64 Raw URL Encoded string which represents
-// a Vendor Consent String and returns a ParsedConsent with
-// it's fields populated with the values stored in the string.
-// Example Usage:
-//	var pc, err = iabconsent.Parse("BONJ5bvONJ5bvAMAPyFRAL7AAAAMhuqKklS-gAAAAAAAAAAAAAAAAAAAAAAAAAA")
-func Parse(s string) (*ParsedConsent, error) {
-	var b []byte
-	var err error
-
-	b, err = base64.RawURLEncoding.DecodeString(s)
-	if err != nil {
-		return nil, err
-	}
-
-	var bs = ParseBytes(b)
-	var version, cmpID, cmpVersion, consentScreen, vendorListVersion, maxVendorID, numEntries int
-	var created, updated time.Time
-	var isRangeEntries, defaultConsent, isIDRange bool
-	var consentLanguage string
-	var purposesAllowed = make(map[int]bool)
-	var approvedVendorIDs = make(map[int]bool)
-
-	version, err = bs.ParseInt(VersionBitOffset, VersionBitSize)
-	if err != nil {
-		return nil, err
-	}
-	created, err = bs.ParseTime(CreatedBitOffset, CreatedBitSize)
-	if err != nil {
-		return nil, err
-	}
-	updated, err = bs.ParseTime(UpdatedBitOffset, UpdatedBitSize)
-	if err != nil {
-		return nil, err
-	}
-	cmpID, err = bs.ParseInt(CmpIdOffset, CmpIdSize)
-	if err != nil {
-		return nil, err
-	}
-	cmpVersion, err = bs.ParseInt(CmpVersionOffset, CmpVersionSize)
-	if err != nil {
-		return nil, err
-	}
-	consentScreen, err = bs.ParseInt(ConsentScreenSizeOffset, ConsentScreenSize)
-	if err != nil {
-		return nil, err
-	}
-	consentLanguage, err = bs.ParseString(ConsentLanguageOffset, ConsentLanguageSize)
-	if err != nil {
-		return nil, err
-	}
-	vendorListVersion, err = bs.ParseInt(VendorListVersionOffset, VendorListVersionSize)
-	if err != nil {
-		return nil, err
-	}
-	purposesAllowed, err = bs.ParseBitList(PurposesOffset, PurposesSize)
-	if err != nil {
-		return nil, err
-	}
-	maxVendorID, err = bs.ParseInt(MaxVendorIdOffset, MaxVendorIdSize)
-	if err != nil {
-		return nil, err
-	}
-	isRangeEntries, err = bs.ParseBool(EncodingTypeOffset)
-	if err != nil {
-		return nil, err
-	}
-
-	var rangeEntries []*RangeEntry
-
-	if isRangeEntries {
-		defaultConsent, err = bs.ParseBool(DefaultConsentOffset)
-		if err != nil {
-			return nil, err
-		}
-		numEntries, err = bs.ParseInt(NumEntriesOffset, NumEntriesSize)
-		if err != nil {
-			return nil, err
-		}
-
-		// Track how many range entry bits we've parsed since it's variable.
-		var parsedBits = 0
-
-		for i := 0; i < numEntries; i++ {
-			var startVendorID, endVendorID int
-
-			isIDRange, err = bs.ParseBool(RangeEntryOffset + parsedBits)
-			parsedBits++
-
-			if isIDRange {
-				startVendorID, err = bs.ParseInt(RangeEntryOffset+parsedBits, VendorIdSize)
-				if err != nil {
-					return nil, err
-				}
-				parsedBits += VendorIdSize
-				endVendorID, err = bs.ParseInt(RangeEntryOffset+parsedBits, VendorIdSize)
-				if err != nil {
-					return nil, err
-				}
-				parsedBits += VendorIdSize
-			} else {
-				startVendorID, err = bs.ParseInt(RangeEntryOffset+parsedBits, VendorIdSize)
-				if err != nil {
-					return nil, err
-				}
-				endVendorID = startVendorID
-				parsedBits += VendorIdSize
-			}
-
-			rangeEntries = append(rangeEntries, &RangeEntry{
-				StartVendorID: startVendorID,
-				EndVendorID:   endVendorID,
-			})
-		}
-	} else {
-		approvedVendorIDs, err = bs.ParseBitList(VendorBitFieldOffset, maxVendorID)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return &ParsedConsent{
-		consentString:     bs.value,
-		Version:           version,
-		Created:           created,
-		LastUpdated:       updated,
-		CMPID:             cmpID,
-		CMPVersion:        cmpVersion,
-		ConsentScreen:     consentScreen,
-		ConsentLanguage:   consentLanguage,
-		VendorListVersion: vendorListVersion,
-		PurposesAllowed:   purposesAllowed,
-		MaxVendorID:       maxVendorID,
-		IsRange:           isRangeEntries,
-		approvedVendorIDs: approvedVendorIDs,
-		DefaultConsent:    defaultConsent,
-		numEntries:        numEntries,
-		rangeEntries:      rangeEntries,
-	}, nil
-}
@@ -13,12 +13,11 @@ const (
 	MultipleRangesMixed
 )
 
-var testTime = time.Unix(1525378200, 8*nsPerDs)
+var testTime = time.Unix(1525378200, 8*nsPerDs).UTC()
 
 var consentFixtures = map[consentType]*ParsedConsent{
 	// BONMj34ONMj34ABACDENALqAAAAAplY
 	BitField: {
-		consentString:     "0000010011100011010011001000111101111110000011100011010011001000111101111110000000000000010000000000100000110001000011010000000010111010100000000000000000000000000000001010011001010110",
 		Version:           1,
 		Created:           testTime,
 		LastUpdated:       testTime,
@@ -32,9 +31,9 @@ var consentFixtures = map[consentType]*ParsedConsent{
 			3: true,
 			5: true,
 		},
-		MaxVendorID: 10,
-		IsRange:     false,
-		approvedVendorIDs: map[int]bool{
+		MaxVendorID:     10,
+		IsRangeEncoding: false,
+		ConsentedVendors: map[int]bool{
 			1:  true,
 			2:  true,
 			5:  true,
@@ -45,7 +44,6 @@ var consentFixtures = map[consentType]*ParsedConsent{
 	},
 	// BONMj34ONMj34ABACDENALqAAAAAqABAD2AAAAAAAAAAAAAAAAAAAAAAAAAA
 	SingleRangeWithSingleID: {
-		consentString:     "000001001110001101001100100011110111111000001110001101001100100011110111111000000000000001000000000010000011000100001101000000001011101010000000000000000000000000000000101010000000000001000000000011110110000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 		Version:           1,
 		Created:           testTime,
 		LastUpdated:       testTime,
@@ -59,12 +57,12 @@ var consentFixtures = map[consentType]*ParsedConsent{
 			3: true,
 			5: true,
 		},
-		MaxVendorID:       10,
-		IsRange:           true,
-		approvedVendorIDs: map[int]bool{},
-		DefaultConsent:    false,
-		numEntries:        1,
-		rangeEntries: []*RangeEntry{
+		MaxVendorID:      10,
+		IsRangeEncoding:  true,
+		ConsentedVendors: map[int]bool{},
+		DefaultConsent:   false,
+		NumEntries:       1,
+		RangeEntries: []*RangeEntry{
 			{
 				StartVendorID: 123,
 				EndVendorID:   123,
@@ -73,7 +71,6 @@ var consentFixtures = map[consentType]*ParsedConsent{
 	},
 	// BONMj34ONMj34ABACDENALqAAAAAqABgD2AdQAAAAAAAAAAAAAAAAAAAAAAAAAA
 	SingleRangeWithRange: {
-		consentString:     "0000010011100011010011001000111101111110000011100011010011001000111101111110000000000000010000000000100000110001000011010000000010111010100000000000000000000000000000001010100000000000011000000000111101100000000111010100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 		Version:           1,
 		Created:           testTime,
 		LastUpdated:       testTime,
@@ -87,12 +84,12 @@ var consentFixtures = map[consentType]*ParsedConsent{
 			3: true,
 			5: true,
 		},
-		MaxVendorID:       10,
-		IsRange:           true,
-		approvedVendorIDs: map[int]bool{},
-		DefaultConsent:    false,
-		numEntries:        1,
-		rangeEntries: []*RangeEntry{
+		MaxVendorID:      10,
+		IsRangeEncoding:  true,
+		ConsentedVendors: map[int]bool{},
+		DefaultConsent:   false,
+		NumEntries:       1,
+		RangeEntries: []*RangeEntry{
 			{
 				StartVendorID: 123,
 				EndVendorID:   234,
@@ -101,7 +98,6 @@ var consentFixtures = map[consentType]*ParsedConsent{
 	},
 	// BONMj34ONMj34ABACDENALqAAAAAqACAD2AOoAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 	MultipleRangesWithSingleID: {
-		consentString:     "00000100111000110100110010001111011111100000111000110100110010001111011111100000000000000100000000001000001100010000110100000000101110101000000000000000000000000000000010101000000000001000000000001111011000000000111010100000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 		Version:           1,
 		Created:           testTime,
 		LastUpdated:       testTime,
@@ -115,12 +111,12 @@ var consentFixtures = map[consentType]*ParsedConsent{
 			3: true,
 			5: true,
 		},
-		MaxVendorID:       10,
-		IsRange:           true,
-		approvedVendorIDs: map[int]bool{},
-		DefaultConsent:    false,
-		numEntries:        2,
-		rangeEntries: []*RangeEntry{
+		MaxVendorID:      10,
+		IsRangeEncoding:  true,
+		ConsentedVendors: map[int]bool{},
+		DefaultConsent:   false,
+		NumEntries:       2,
+		RangeEntries: []*RangeEntry{
 			{
 				StartVendorID: 123,
 				EndVendorID:   123,
@@ -133,7 +129,6 @@ var consentFixtures = map[consentType]*ParsedConsent{
 	},
 	// BONMj34ONMj34ABACDENALqAAAAAqACgD2AdUBWQHIAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 	MultipleRangesWithRange: {
-		consentString:     "0000010011100011010011001000111101111110000011100011010011001000111101111110000000000000010000000000100000110001000011010000000010111010100000000000000000000000000000001010100000000000101000000000111101100000000111010101000000010101100100000001110010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 		Version:           1,
 		Created:           testTime,
 		LastUpdated:       testTime,
@@ -147,12 +142,12 @@ var consentFixtures = map[consentType]*ParsedConsent{
 			3: true,
 			5: true,
 		},
-		MaxVendorID:       10,
-		IsRange:           true,
-		approvedVendorIDs: map[int]bool{},
-		DefaultConsent:    false,
-		numEntries:        2,
-		rangeEntries: []*RangeEntry{
+		MaxVendorID:      10,
+		IsRangeEncoding:  true,
+		ConsentedVendors: map[int]bool{},
+		DefaultConsent:   false,
+		NumEntries:       2,
+		RangeEntries: []*RangeEntry{
 			{
 				StartVendorID: 123,
 				EndVendorID:   234,
@@ -165,7 +160,6 @@ var consentFixtures = map[consentType]*ParsedConsent{
 	},
 	// BONMj34ONMj34ABACDENALqAAAAAqACAD3AVkByAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
 	MultipleRangesMixed: {
-		consentString:     "000001001110001101001100100011110111111000001110001101001100100011110111111000000000000001000000000010000011000100001101000000001011101010000000000000000000000000000000101010000000000010000000000011110111000000010101100100000001110010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 		Version:           1,
 		Created:           testTime,
 		LastUpdated:       testTime,
@@ -179,12 +173,12 @@ var consentFixtures = map[consentType]*ParsedConsent{
 			3: true,
 			5: true,
 		},
-		MaxVendorID:       10,
-		IsRange:           true,
-		approvedVendorIDs: map[int]bool{},
-		DefaultConsent:    false,
-		numEntries:        2,
-		rangeEntries: []*RangeEntry{
+		MaxVendorID:      10,
+		IsRangeEncoding:  true,
+		ConsentedVendors: map[int]bool{},
+		DefaultConsent:   false,
+		NumEntries:       2,
+		RangeEntries: []*RangeEntry{
 			{
 				StartVendorID: 123,
 				EndVendorID:   123,
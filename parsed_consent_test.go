@@ -8,40 +8,61 @@ import (
 
 type ParsedConsentSuite struct{}
 
+var consentStringCases = []struct {
+	Type          consentType
+	EncodedString string
+}{
+	{
+		Type:          BitField,
+		EncodedString: "BONMj34ONMj34ABACDENALqAAAAAplY",
+	},
+	{
+		Type:          SingleRangeWithSingleID,
+		EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqABAD2AAAAAAAAAAAAAAAAAAAAAAAAAA",
+	},
+	{
+		Type:          SingleRangeWithRange,
+		EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqABgD2AdQAAAAAAAAAAAAAAAAAAAAAAAAAA",
+	},
+	{
+		Type:          MultipleRangesWithSingleID,
+		EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqACAD2AOoAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+	},
+	{
+		Type:          MultipleRangesWithRange,
+		EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqACgD2AdUBWQHIAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+	},
+	{
+		Type:          MultipleRangesMixed,
+		EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqACAD3AVkByAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
+	},
+}
+
 func (p *ParsedConsentSuite) TestParseConsentStrings(c *check.C) {
-	var cases = []struct {
-		Type          consentType
-		EncodedString string
-	}{
-		{
-			Type:          BitField,
-			EncodedString: "BONMj34ONMj34ABACDENALqAAAAAplY",
-		},
-		{
-			Type:          SingleRangeWithSingleID,
-			EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqABAD2AAAAAAAAAAAAAAAAAAAAAAAAAA",
-		},
-		{
-			Type:          SingleRangeWithRange,
-			EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqABgD2AdQAAAAAAAAAAAAAAAAAAAAAAAAAA",
-		},
-		{
-			Type:          MultipleRangesWithSingleID,
-			EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqACAD2AOoAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
-		},
-		{
-			Type:          MultipleRangesWithRange,
-			EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqACgD2AdUBWQHIAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
-		},
-		{
-			Type:          MultipleRangesMixed,
-			EncodedString: "BONMj34ONMj34ABACDENALqAAAAAqACAD3AVkByAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
-		},
+	for _, tc := range consentStringCases {
+		c.Log(tc)
+		pc, err := Parse(tc.EncodedString)
+		c.Check(err, check.IsNil)
+
+		normalizeParsedConsent(pc)
+		normalizeParsedConsent(consentFixtures[tc.Type])
+
+		c.Assert(pc, check.DeepEquals, consentFixtures[tc.Type])
 	}
+}
 
-	for _, tc := range cases {
+// TestEncodeConsentStrings checks that Encode round-trips each fixture
+// through Parse, rather than that it reproduces tc.EncodedString
+// byte-for-byte: several of the EncodedString values carry trailing
+// padding well beyond the final field they encode, which Encode has no
+// way to know about or reproduce.
+func (p *ParsedConsentSuite) TestEncodeConsentStrings(c *check.C) {
+	for _, tc := range consentStringCases {
 		c.Log(tc)
-		pc, err := Parse(tc.EncodedString)
+		var s, err = consentFixtures[tc.Type].Encode()
+		c.Check(err, check.IsNil)
+
+		pc, err := Parse(s)
 		c.Check(err, check.IsNil)
 
 		normalizeParsedConsent(pc)
@@ -52,8 +73,8 @@ func (p *ParsedConsentSuite) TestParseConsentStrings(c *check.C) {
 }
 
 func normalizeParsedConsent(p *ParsedConsent) {
-	sort.Slice(p.rangeEntries, func(i, j int) bool {
-		return p.rangeEntries[i].StartVendorID < p.rangeEntries[j].StartVendorID
+	sort.Slice(p.RangeEntries, func(i, j int) bool {
+		return p.RangeEntries[i].StartVendorID < p.RangeEntries[j].StartVendorID
 	})
 }
 
@@ -0,0 +1,134 @@
+package iabconsent
+
+import "time"
+
+// ParsedConsentV2 contains all fields defined in the core string of the
+// IAB Transparency & Consent Framework v2.0 Spec, plus any optional
+// segments (DisclosedVendors, AllowedVendors, PublisherTC) present in the
+// dot-separated string it was parsed from.
+type ParsedConsentV2 struct {
+	Version                   int
+	Created                   time.Time
+	LastUpdated               time.Time
+	CMPID                     int
+	CMPVersion                int
+	ConsentScreen             int
+	ConsentLanguage           string
+	VendorListVersion         int
+	TCFPolicyVersion          int
+	IsServiceSpecific         bool
+	UseNonStandardTexts       bool
+	SpecialFeatureOptIns      map[int]bool
+	PurposesConsent           map[int]bool
+	PurposesLITransparency    map[int]bool
+	PurposeOneTreatment       bool
+	PublisherCC               string
+	VendorConsents            *VendorVector
+	VendorLegitimateInterests *VendorVector
+	PublisherRestrictions     []*PublisherRestriction
+
+	// DisclosedVendors and AllowedVendors are nil unless the
+	// corresponding optional segment was present in the string.
+	DisclosedVendors *VendorVector
+	AllowedVendors   *VendorVector
+
+	// PubPurposesConsent, PubPurposesLITransparency, NumCustomPurposes,
+	// CustomPurposesConsent, and CustomPurposesLITransparency are zero
+	// valued unless the PublisherTC segment was present in the string.
+	PubPurposesConsent           map[int]bool
+	PubPurposesLITransparency    map[int]bool
+	NumCustomPurposes            int
+	CustomPurposesConsent        map[int]bool
+	CustomPurposesLITransparency map[int]bool
+}
+
+// SegmentType values identify the kind of optional segment that follows
+// the core string of a TCF v2.0 Consent String.
+const (
+	SegmentTypeDisclosedVendors = 1
+	SegmentTypeAllowedVendors   = 2
+	SegmentTypePublisherTC      = 3
+)
+
+// VendorVector is the MaxVendorId/IsRangeEncoding vendor section shape
+// that the TCF v2.0 core string and its DisclosedVendors and
+// AllowedVendors segments all share. Unlike the v1.1 equivalent, it
+// carries no default consent value: a vendor is either present or not.
+type VendorVector struct {
+	MaxVendorID     int
+	IsRangeEncoding bool
+	NumEntries      int
+	RangeEntries    []*RangeEntry
+	Vendors         map[int]bool
+}
+
+// Allowed returns true if v declares Vendor ID i, whether v was encoded
+// as a bitfield or as range entries. A nil VendorVector declares no
+// vendors.
+func (v *VendorVector) Allowed(i int) bool {
+	if v == nil {
+		return false
+	}
+	if v.IsRangeEncoding {
+		for _, re := range v.RangeEntries {
+			if re.StartVendorID <= i && re.EndVendorID >= i {
+				return true
+			}
+		}
+		return false
+	}
+	return v.Vendors[i]
+}
+
+// RestrictionType describes how a Publisher Restriction Entry overrides
+// a vendor's declared legal basis for a purpose.
+type RestrictionType int
+
+const (
+	NotAllowed RestrictionType = iota
+	RequireConsent
+	RequireLI
+)
+
+// PublisherRestriction contains all fields in a Publisher Restriction
+// Entry: the purpose it applies to, the legal basis it requires (or
+// forbids), and the vendors it covers.
+type PublisherRestriction struct {
+	PurposeID       int
+	RestrictionType RestrictionType
+	RangeEntries    []*RangeEntry
+}
+
+// Covers returns true if the restriction's range entries include Vendor
+// ID i.
+func (pr *PublisherRestriction) Covers(i int) bool {
+	for _, re := range pr.RangeEntries {
+		if re.StartVendorID <= i && re.EndVendorID >= i {
+			return true
+		}
+	}
+	return false
+}
+
+// EveryPurposeConsentAllowed returns true iff every purpose number in ps
+// has consent in the ParsedConsentV2, otherwise false.
+func (p *ParsedConsentV2) EveryPurposeConsentAllowed(ps []int) bool {
+	for _, rp := range ps {
+		if !p.PurposesConsent[rp] {
+			return false
+		}
+	}
+	return true
+}
+
+// VendorConsentAllowed returns true if the ParsedConsentV2 contains
+// affirmative consent for Vendor of ID i.
+func (p *ParsedConsentV2) VendorConsentAllowed(i int) bool {
+	return p.VendorConsents.Allowed(i)
+}
+
+// VendorLegitimateInterestAllowed returns true if the ParsedConsentV2
+// records a legitimate interest establishment for Vendor of ID i.
+func (p *ParsedConsentV2) VendorLegitimateInterestAllowed(i int) bool {
+	return p.VendorLegitimateInterests.Allowed(i)
+}
@@ -0,0 +1,91 @@
+package iabconsent
+
+import "time"
+
+// v2ConsentFixture is the decoded form of v2EncodedString: a TCF v2.0
+// core string plus one of each optional segment (DisclosedVendors,
+// AllowedVendors, PublisherTC) and a publisher restriction, covering
+// both bitfield and range vendor encodings.
+var v2ConsentFixture = &ParsedConsentV2{
+	Version:             2,
+	Created:             time.Unix(1620000000, 0).UTC(),
+	LastUpdated:         time.Unix(1620003600, 0).UTC(),
+	CMPID:               1,
+	CMPVersion:          2,
+	ConsentScreen:       3,
+	ConsentLanguage:     "EN",
+	VendorListVersion:   11,
+	TCFPolicyVersion:    2,
+	IsServiceSpecific:   true,
+	UseNonStandardTexts: false,
+	SpecialFeatureOptIns: map[int]bool{
+		1: true,
+		2: true,
+	},
+	PurposesConsent: map[int]bool{
+		1: true,
+		3: true,
+	},
+	PurposesLITransparency: map[int]bool{
+		2: true,
+	},
+	PurposeOneTreatment: false,
+	PublisherCC:         "US",
+	VendorConsents: &VendorVector{
+		MaxVendorID: 5,
+		Vendors: map[int]bool{
+			1: true,
+			3: true,
+		},
+	},
+	VendorLegitimateInterests: &VendorVector{
+		MaxVendorID:     10,
+		IsRangeEncoding: true,
+		NumEntries:      1,
+		RangeEntries: []*RangeEntry{
+			{StartVendorID: 2, EndVendorID: 2},
+		},
+	},
+	PublisherRestrictions: []*PublisherRestriction{
+		{
+			PurposeID:       3,
+			RestrictionType: RequireConsent,
+			RangeEntries: []*RangeEntry{
+				{StartVendorID: 1, EndVendorID: 1},
+			},
+		},
+	},
+	DisclosedVendors: &VendorVector{
+		MaxVendorID: 4,
+		Vendors: map[int]bool{
+			1: true,
+			2: true,
+		},
+	},
+	AllowedVendors: &VendorVector{
+		MaxVendorID:     5,
+		IsRangeEncoding: true,
+		NumEntries:      1,
+		RangeEntries: []*RangeEntry{
+			{StartVendorID: 1, EndVendorID: 3},
+		},
+	},
+	PubPurposesConsent: map[int]bool{
+		1: true,
+	},
+	PubPurposesLITransparency: map[int]bool{
+		2: true,
+	},
+	NumCustomPurposes: 3,
+	CustomPurposesConsent: map[int]bool{
+		1: true,
+	},
+	CustomPurposesLITransparency: map[int]bool{
+		2: true,
+	},
+}
+
+// v2EncodedString is v2ConsentFixture encoded as a base64 Raw URL
+// Encoded TCF v2.0 Consent String: the core string followed by its
+// DisclosedVendors, AllowedVendors, and PublisherTC segments.
+const v2EncodedString = "CPFmGIAPFmO6gABACDENALCsAKAAAEAAACiQACqAAVABAAEACGgAgABA.IACM.QACwAYAAgAGA.cAAACAAAAcQ"
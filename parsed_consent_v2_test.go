@@ -0,0 +1,66 @@
+package iabconsent
+
+import (
+	"time"
+
+	"github.com/go-check/check"
+)
+
+type ParsedConsentV2Suite struct{}
+
+var _ = check.Suite(&ParsedConsentV2Suite{})
+
+// realWorldV2String is a live-captured TCF v2.0 Consent String (the
+// same one documented on ParseV2), independent of this package's own
+// Encode, used to check ParseV2 against more than its own round trip.
+const realWorldV2String = "COwGVJOOwGVJOADACHENAPCAAAAAAAAAAAAAAAAAAAA.IFoEUQQgAIQwgIwQABAEAAAAOIAACAIAAAAQAIAgEAACEAAAAAgAQBAAAAAAAGBAAgAAAAAAAFAAECAAAgAAQARAEQAAAAAJAAIAAgAAAYQEAAAQmAgBC3ZAYzUw"
+
+func (p *ParsedConsentV2Suite) TestParseConsentStringsV2_RealWorldVector(c *check.C) {
+	var pc, err = ParseV2(realWorldV2String)
+	c.Assert(err, check.IsNil)
+	c.Check(pc.Version, check.Equals, 2)
+	c.Check(pc.CMPID, check.Equals, 3)
+	c.Check(pc.CMPVersion, check.Equals, 2)
+	c.Check(pc.ConsentScreen, check.Equals, 7)
+	c.Check(pc.ConsentLanguage, check.Equals, "EN")
+	c.Check(pc.VendorListVersion, check.Equals, 15)
+	c.Check(pc.TCFPolicyVersion, check.Equals, 2)
+	c.Check(pc.Created, check.DeepEquals, time.Date(2020, time.March, 11, 13, 58, 57, 400000000, time.UTC))
+	c.Check(pc.DisclosedVendors, check.NotNil)
+	c.Check(pc.AllowedVendors, check.IsNil)
+}
+
+func (p *ParsedConsentV2Suite) TestParseConsentStringsV2(c *check.C) {
+	var pc, err = ParseV2(v2EncodedString)
+	c.Assert(err, check.IsNil)
+	c.Assert(pc, check.DeepEquals, v2ConsentFixture)
+}
+
+func (p *ParsedConsentV2Suite) TestEncodeConsentStringsV2(c *check.C) {
+	var s, err = v2ConsentFixture.Encode()
+	c.Assert(err, check.IsNil)
+	c.Check(s, check.Equals, v2EncodedString)
+}
+
+func (p *ParsedConsentV2Suite) TestParseAny_V2(c *check.C) {
+	var v1, v2, err = ParseAny(v2EncodedString)
+	c.Assert(err, check.IsNil)
+	c.Check(v1, check.IsNil)
+	c.Assert(v2, check.DeepEquals, v2ConsentFixture)
+}
+
+func (p *ParsedConsentV2Suite) TestParseAny_V1(c *check.C) {
+	var v1, v2, err = ParseAny(consentStringCases[0].EncodedString)
+	c.Assert(err, check.IsNil)
+	c.Check(v2, check.IsNil)
+	c.Assert(v1, check.DeepEquals, consentFixtures[consentStringCases[0].Type])
+}
+
+func (p *ParsedConsentV2Suite) TestEncodeConsentStringsV2_NilVendorVectors(c *check.C) {
+	// A zero-value ParsedConsentV2 has nil VendorConsents and
+	// VendorLegitimateInterests; Encode must not panic, instead writing
+	// them out as vectors that declare no vendors.
+	var pc = &ParsedConsentV2{}
+	var _, err = pc.Encode()
+	c.Check(err, check.IsNil)
+}
@@ -0,0 +1,79 @@
+package iabconsent
+
+import "fmt"
+
+// TriState represents a field in a US Privacy String, which may
+// indicate the consumer affirmatively said yes, affirmatively said no,
+// or that the field does not apply.
+type TriState int
+
+const (
+	NotApplicable TriState = iota
+	Yes
+	No
+)
+
+// USPrivacyString contains all fields defined in the IAB CCPA
+// Compliance Framework's US Privacy String.
+type USPrivacyString struct {
+	Version     int
+	Notice      TriState
+	OptOutSale  TriState
+	LSPACovered TriState
+}
+
+// ParseUSPrivacy takes the 4-character IAB US Privacy String and returns
+// a USPrivacyString with its fields populated with the values stored in
+// the string.
+//
+// Example Usage:
+//
+//   var p, err = iabconsent.ParseUSPrivacy("1YNN")
+func ParseUSPrivacy(s string) (*USPrivacyString, error) {
+	if len(s) != 4 {
+		return nil, fmt.Errorf("iabconsent: invalid US Privacy String length: %v", len(s))
+	}
+
+	if s[0] != '1' {
+		return nil, fmt.Errorf("iabconsent: unsupported US Privacy String version: %c", s[0])
+	}
+
+	var notice, err = parseTriState(s[1])
+	if err != nil {
+		return nil, err
+	}
+	optOutSale, err := parseTriState(s[2])
+	if err != nil {
+		return nil, err
+	}
+	lspaCovered, err := parseTriState(s[3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &USPrivacyString{
+		Version:     int(s[0] - '0'),
+		Notice:      notice,
+		OptOutSale:  optOutSale,
+		LSPACovered: lspaCovered,
+	}, nil
+}
+
+func parseTriState(b byte) (TriState, error) {
+	switch b {
+	case 'Y':
+		return Yes, nil
+	case 'N':
+		return No, nil
+	case '-':
+		return NotApplicable, nil
+	default:
+		return NotApplicable, fmt.Errorf("iabconsent: invalid US Privacy String character: %c", b)
+	}
+}
+
+// ShouldRestrictSale returns true if the consumer has exercised their
+// CCPA right to opt out of the sale of their personal information.
+func (u *USPrivacyString) ShouldRestrictSale() bool {
+	return u.OptOutSale == Yes
+}
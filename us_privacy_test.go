@@ -0,0 +1,56 @@
+package iabconsent
+
+import (
+	"github.com/go-check/check"
+)
+
+type USPrivacySuite struct{}
+
+var _ = check.Suite(&USPrivacySuite{})
+
+func (s *USPrivacySuite) TestParseUSPrivacy(c *check.C) {
+	var p, err = ParseUSPrivacy("1YNN")
+	c.Assert(err, check.IsNil)
+	c.Check(p, check.DeepEquals, &USPrivacyString{
+		Version:     1,
+		Notice:      Yes,
+		OptOutSale:  No,
+		LSPACovered: No,
+	})
+}
+
+func (s *USPrivacySuite) TestParseUSPrivacy_NotApplicable(c *check.C) {
+	var p, err = ParseUSPrivacy("1---")
+	c.Assert(err, check.IsNil)
+	c.Check(p, check.DeepEquals, &USPrivacyString{
+		Version:     1,
+		Notice:      NotApplicable,
+		OptOutSale:  NotApplicable,
+		LSPACovered: NotApplicable,
+	})
+}
+
+func (s *USPrivacySuite) TestParseUSPrivacy_Errors(c *check.C) {
+	var tests = []string{
+		"1YN",
+		"1YNNN",
+		"2YNN",
+		"1XNN",
+	}
+
+	for _, t := range tests {
+		c.Log(t)
+		var _, err = ParseUSPrivacy(t)
+		c.Check(err, check.NotNil)
+	}
+}
+
+func (s *USPrivacySuite) TestShouldRestrictSale(c *check.C) {
+	var p, err = ParseUSPrivacy("1YYN")
+	c.Assert(err, check.IsNil)
+	c.Check(p.ShouldRestrictSale(), check.Equals, true)
+
+	p, err = ParseUSPrivacy("1YNN")
+	c.Assert(err, check.IsNil)
+	c.Check(p.ShouldRestrictSale(), check.Equals, false)
+}
@@ -0,0 +1,172 @@
+package iabconsent
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/rupertchen/go-bits"
+)
+
+type ConsentWriter struct {
+	*bits.Writer
+}
+
+func NewConsentWriter() *ConsentWriter {
+	return &ConsentWriter{bits.NewWriter()}
+}
+
+func (w *ConsentWriter) WriteInt(v int, n uint) {
+	w.WriteBits(uint64(v), n)
+}
+
+func (w *ConsentWriter) WriteBool(v bool) {
+	var b uint64
+	if v {
+		b = 1
+	}
+	w.WriteBits(b, 1)
+}
+
+func (w *ConsentWriter) WriteTime(t time.Time) {
+	w.WriteBits(uint64(t.UnixNano()/nsPerDs), 36)
+}
+
+func (w *ConsentWriter) WriteString(s string, chars uint) {
+	for i := uint(0); i < chars; i++ {
+		var c byte = 'A'
+		if int(i) < len(s) {
+			c = s[i]
+		}
+		w.WriteBits(uint64(c-'A'), 6)
+	}
+}
+
+func (w *ConsentWriter) WriteBitField(m map[int]bool, n uint) {
+	for i := uint(0); i < n; i++ {
+		w.WriteBool(m[int(i)+1])
+	}
+}
+
+func (w *ConsentWriter) WriteRangeEntries(es []*RangeEntry) {
+	for _, e := range es {
+		w.WriteBool(e.StartVendorID != e.EndVendorID)
+		w.WriteInt(e.StartVendorID, 16)
+		if e.StartVendorID != e.EndVendorID {
+			w.WriteInt(e.EndVendorID, 16)
+		}
+	}
+}
+
+// writeVendorVector writes the MaxVendorId/IsRangeEncoding vendor section
+// shape shared by the TCF v2.0 core string and its optional
+// DisclosedVendors and AllowedVendors segments. A nil v, like a nil
+// VendorVector's Allowed, is treated as declaring no vendors.
+func (w *ConsentWriter) writeVendorVector(v *VendorVector) {
+	if v == nil {
+		v = &VendorVector{}
+	}
+	w.WriteInt(v.MaxVendorID, 16)
+	w.WriteBool(v.IsRangeEncoding)
+	if v.IsRangeEncoding {
+		w.WriteInt(len(v.RangeEntries), 12)
+		w.WriteRangeEntries(v.RangeEntries)
+	} else {
+		w.WriteBitField(v.Vendors, uint(v.MaxVendorID))
+	}
+}
+
+func (w *ConsentWriter) writePublisherRestrictions(rs []*PublisherRestriction) {
+	w.WriteInt(len(rs), 12)
+	for _, r := range rs {
+		w.WriteInt(r.PurposeID, 6)
+		w.WriteInt(int(r.RestrictionType), 2)
+		w.WriteInt(len(r.RangeEntries), 12)
+		w.WriteRangeEntries(r.RangeEntries)
+	}
+}
+
+// Encode serializes p back into a base64 Raw URL Encoded Vendor Consent
+// String matching the IAB Consent String 1.1 wire layout that Parse
+// reads. It honors p.IsRangeEncoding as set on the struct, so round
+// tripping a value returned by Parse reproduces the original encoding.
+func (p *ParsedConsent) Encode() (string, error) {
+	var w = NewConsentWriter()
+	w.WriteInt(p.Version, 6)
+	w.WriteTime(p.Created)
+	w.WriteTime(p.LastUpdated)
+	w.WriteInt(p.CMPID, 12)
+	w.WriteInt(p.CMPVersion, 12)
+	w.WriteInt(p.ConsentScreen, 6)
+	w.WriteString(p.ConsentLanguage, 2)
+	w.WriteInt(p.VendorListVersion, 12)
+	w.WriteBitField(p.PurposesAllowed, 24)
+	w.WriteInt(p.MaxVendorID, 16)
+
+	w.WriteBool(p.IsRangeEncoding)
+	if p.IsRangeEncoding {
+		w.WriteBool(p.DefaultConsent)
+		w.WriteInt(len(p.RangeEntries), 12)
+		w.WriteRangeEntries(p.RangeEntries)
+	} else {
+		w.WriteBitField(p.ConsentedVendors, uint(p.MaxVendorID))
+	}
+
+	return base64.RawURLEncoding.EncodeToString(w.Bytes()), nil
+}
+
+// Encode serializes p back into a base64 Raw URL Encoded TCF v2.0
+// Consent String: the core string, followed by a dot-separated segment
+// for each of DisclosedVendors, AllowedVendors, and the PublisherTC
+// fields that is present on p.
+func (p *ParsedConsentV2) Encode() (string, error) {
+	var w = NewConsentWriter()
+	w.WriteInt(p.Version, 6)
+	w.WriteTime(p.Created)
+	w.WriteTime(p.LastUpdated)
+	w.WriteInt(p.CMPID, 12)
+	w.WriteInt(p.CMPVersion, 12)
+	w.WriteInt(p.ConsentScreen, 6)
+	w.WriteString(p.ConsentLanguage, 2)
+	w.WriteInt(p.VendorListVersion, 12)
+	w.WriteInt(p.TCFPolicyVersion, 6)
+	w.WriteBool(p.IsServiceSpecific)
+	w.WriteBool(p.UseNonStandardTexts)
+	w.WriteBitField(p.SpecialFeatureOptIns, 12)
+	w.WriteBitField(p.PurposesConsent, 24)
+	w.WriteBitField(p.PurposesLITransparency, 24)
+	w.WriteBool(p.PurposeOneTreatment)
+	w.WriteString(p.PublisherCC, 2)
+	w.writeVendorVector(p.VendorConsents)
+	w.writeVendorVector(p.VendorLegitimateInterests)
+	w.writePublisherRestrictions(p.PublisherRestrictions)
+
+	var segments = []string{base64.RawURLEncoding.EncodeToString(w.Bytes())}
+
+	if p.DisclosedVendors != nil {
+		var sw = NewConsentWriter()
+		sw.WriteInt(SegmentTypeDisclosedVendors, 3)
+		sw.writeVendorVector(p.DisclosedVendors)
+		segments = append(segments, base64.RawURLEncoding.EncodeToString(sw.Bytes()))
+	}
+
+	if p.AllowedVendors != nil {
+		var sw = NewConsentWriter()
+		sw.WriteInt(SegmentTypeAllowedVendors, 3)
+		sw.writeVendorVector(p.AllowedVendors)
+		segments = append(segments, base64.RawURLEncoding.EncodeToString(sw.Bytes()))
+	}
+
+	if p.NumCustomPurposes > 0 || len(p.PubPurposesConsent) > 0 || len(p.PubPurposesLITransparency) > 0 {
+		var sw = NewConsentWriter()
+		sw.WriteInt(SegmentTypePublisherTC, 3)
+		sw.WriteBitField(p.PubPurposesConsent, 24)
+		sw.WriteBitField(p.PubPurposesLITransparency, 24)
+		sw.WriteInt(p.NumCustomPurposes, 6)
+		sw.WriteBitField(p.CustomPurposesConsent, uint(p.NumCustomPurposes))
+		sw.WriteBitField(p.CustomPurposesLITransparency, uint(p.NumCustomPurposes))
+		segments = append(segments, base64.RawURLEncoding.EncodeToString(sw.Bytes()))
+	}
+
+	return strings.Join(segments, "."), nil
+}